@@ -0,0 +1,235 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// wrap adapts a legacy cmdXxx(args []string) error function into a cobra
+// RunE. These commands still parse their own flags internally via
+// flag.NewFlagSet, so flag parsing is disabled on the cobra side and the
+// raw args are forwarded unchanged.
+func wrap(fn func([]string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return fn(args)
+	}
+}
+
+// distinctCategories returns every category currently present in the
+// store, used to power --category shell completion.
+func distinctCategories() []string {
+	st, err := loadStore()
+	if err != nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var out []string
+	for _, e := range st.Expenses {
+		if e.Category == "" || seen[e.Category] {
+			continue
+		}
+		seen[e.Category] = true
+		out = append(out, e.Category)
+	}
+	return out
+}
+
+// expenseIDs returns every expense ID currently in the store, used to
+// power --id shell completion.
+func expenseIDs() []string {
+	st, err := loadStore()
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(st.Expenses))
+	for _, e := range st.Expenses {
+		out = append(out, strconv.Itoa(e.ID))
+	}
+	return out
+}
+
+func completeCategories(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var out []string
+	for _, c := range distinctCategories() {
+		if strings.HasPrefix(strings.ToLower(c), strings.ToLower(toComplete)) {
+			out = append(out, c)
+		}
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var out []string
+	for _, id := range expenseIDs() {
+		if strings.HasPrefix(id, toComplete) {
+			out = append(out, id)
+		}
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
+// registerCategoryCompletion wires --category completion onto cmd. The
+// flag itself is still parsed by the legacy flag.NewFlagSet inside the
+// wrapped cmdXxx function; it is redeclared here purely so cobra's
+// completion machinery knows the flag exists.
+func registerCategoryCompletion(cmd *cobra.Command) {
+	cmd.Flags().String("category", "", "filter by category")
+	_ = cmd.RegisterFlagCompletionFunc("category", completeCategories)
+}
+
+func registerIDCompletion(cmd *cobra.Command) {
+	cmd.Flags().Int("id", 0, "expense ID")
+	_ = cmd.RegisterFlagCompletionFunc("id", completeIDs)
+}
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:           appName,
+		Short:         appName + " — simple CLI expense tracker",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	add := &cobra.Command{
+		Use:                "add",
+		Short:              "Record a new expense",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdAdd),
+	}
+
+	update := &cobra.Command{
+		Use:                "update",
+		Short:              "Update an existing expense",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdUpdate),
+	}
+	registerIDCompletion(update)
+	registerCategoryCompletion(update)
+
+	del := &cobra.Command{
+		Use:                "delete",
+		Short:              "Delete an expense",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdDelete),
+	}
+	registerIDCompletion(del)
+
+	list := &cobra.Command{
+		Use:                "list",
+		Short:              "List expenses",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdList),
+	}
+	registerCategoryCompletion(list)
+
+	summary := &cobra.Command{
+		Use:                "summary",
+		Short:              "Show total expenses, optionally by month/category",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdSummary),
+	}
+	registerCategoryCompletion(summary)
+
+	budget := &cobra.Command{
+		Use:                "budget",
+		Short:              "Get or set a monthly budget",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdBudget),
+	}
+
+	export := &cobra.Command{
+		Use:                "export",
+		Short:              "Export expenses (csv, json, ledger, xlsx, html)",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdExport),
+	}
+	registerCategoryCompletion(export)
+
+	account := &cobra.Command{
+		Use:                "account",
+		Short:              "Manage accounts (add, list)",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdAccount),
+	}
+
+	transfer := &cobra.Command{
+		Use:                "transfer",
+		Short:              "Record a transfer between two accounts",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdTransfer),
+	}
+
+	balance := &cobra.Command{
+		Use:                "balance",
+		Short:              "Show per-account running balances",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdBalance),
+	}
+
+	importCmd := &cobra.Command{
+		Use:                "import",
+		Short:              "Import expenses from a ledger journal",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdImport),
+	}
+
+	recurring := &cobra.Command{
+		Use:                "recurring",
+		Short:              "Manage fixed monthly expenses (add, list, apply)",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdRecurring),
+	}
+
+	daily := &cobra.Command{
+		Use:                "daily",
+		Short:              "Show the daily amortized cost for a month",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdDaily),
+	}
+
+	show := &cobra.Command{
+		Use:                "show <YYYY-MM>",
+		Short:              "Show per-category breakdown for a month",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdShow),
+	}
+
+	tui := &cobra.Command{
+		Use:                "tui",
+		Short:              "Launch the interactive full-screen expense browser",
+		DisableFlagParsing: true,
+		RunE:               wrap(cmdTUI),
+	}
+
+	root.AddCommand(add, update, del, list, summary, budget, export,
+		account, transfer, balance, importCmd, recurring, daily, show, tui,
+		newCompletionCmd(root))
+
+	return root
+}
+
+func newCompletionCmd(root *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:       "completion [bash|zsh|fish|powershell]",
+		Short:     "Generate a shell completion script",
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+		Args:      cobra.ExactValidArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletion(os.Stdout)
+			case "zsh":
+				return root.GenZshCompletion(os.Stdout)
+			case "fish":
+				return root.GenFishCompletion(os.Stdout, true)
+			case "powershell":
+				return root.GenPowerShellCompletionWithDesc(os.Stdout)
+			default:
+				return nil
+			}
+		},
+	}
+}
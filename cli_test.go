@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestCategoryCompletion(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAdd([]string{"--description", "Coffee", "--amount", "5", "--category", "Food"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := cmdAdd([]string{"--description", "Gas", "--amount", "40", "--category", "Fuel"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	suggestions, _ := completeCategories(nil, nil, "Fo")
+	if len(suggestions) != 1 || suggestions[0] != "Food" {
+		t.Fatalf("expected only Food for prefix Fo, got %v", suggestions)
+	}
+
+	all, _ := completeCategories(nil, nil, "")
+	if len(all) != 2 {
+		t.Fatalf("expected 2 categories, got %v", all)
+	}
+}
+
+func TestIDCompletion(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAdd([]string{"--description", "Coffee", "--amount", "5"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := cmdAdd([]string{"--description", "Gas", "--amount", "40"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	suggestions, _ := completeIDs(nil, nil, "1")
+	if len(suggestions) != 1 || suggestions[0] != "1" {
+		t.Fatalf("expected only ID 1 for prefix 1, got %v", suggestions)
+	}
+}
@@ -0,0 +1,26 @@
+package exporters
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// CSVExporter writes records as a flat CSV, one row per expense.
+type CSVExporter struct{}
+
+func (CSVExporter) Write(w io.Writer, records []Record, _ Stats) error {
+	cw := csv.NewWriter(w)
+	defer cw.Flush()
+	if err := cw.Write([]string{"id", "date", "description", "category", "account", "amount"}); err != nil {
+		return err
+	}
+	for _, r := range records {
+		row := []string{strconv.Itoa(r.ID), r.Date, r.Description, r.Category, r.Account, fmt.Sprintf("%.2f", r.Amount)}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	return cw.Error()
+}
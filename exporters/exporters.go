@@ -0,0 +1,65 @@
+// Package exporters implements the pluggable output formats for
+// `expense-tracker export` (csv, json, ledger, xlsx, html).
+package exporters
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Record is the subset of expense data an Exporter needs to write a row.
+type Record struct {
+	ID          int
+	Date        string // YYYY-MM-DD
+	Description string
+	Category    string
+	Account     string
+	Amount      float64
+	IsTransfer  bool
+	TransferID  int // links the two legs of a transfer; 0 when IsTransfer is false
+}
+
+// BudgetLine compares a month's budget against what was actually spent.
+type BudgetLine struct {
+	Month  string // YYYY-MM
+	Budget float64
+	Spent  float64
+}
+
+// Stats carries the aggregate figures richer formats (xlsx, html) render
+// alongside the raw rows.
+type Stats struct {
+	CategoryTotals map[string]float64 // category -> total
+	MonthlyTotals  map[string]float64 // YYYY-MM -> total
+	Budgets        []BudgetLine
+}
+
+// Exporter writes records and their aggregate stats to w in a specific format.
+type Exporter interface {
+	Write(w io.Writer, records []Record, stats Stats) error
+}
+
+// New returns the Exporter for format. If format is empty, it is inferred
+// from outputPath's extension.
+func New(format string, outputPath string) (Exporter, error) {
+	f := strings.ToLower(strings.TrimSpace(format))
+	if f == "" {
+		f = strings.TrimPrefix(strings.ToLower(filepath.Ext(outputPath)), ".")
+	}
+	switch f {
+	case "", "csv":
+		return CSVExporter{}, nil
+	case "json":
+		return JSONExporter{}, nil
+	case "ledger":
+		return LedgerExporter{}, nil
+	case "xlsx":
+		return XLSXExporter{}, nil
+	case "html", "htm":
+		return HTMLExporter{OutputDir: filepath.Dir(outputPath)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
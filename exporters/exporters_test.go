@@ -0,0 +1,140 @@
+package exporters
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tealeg/xlsx"
+)
+
+func sampleRecords() []Record {
+	return []Record{
+		{ID: 1, Date: "2025-08-01", Description: "Coffee", Category: "Food", Account: "CASH", Amount: 5},
+		{ID: 2, Date: "2025-08-02", Description: "Fuel", Category: "Auto", Account: "CASH", Amount: 40},
+	}
+}
+
+func TestCSVExporterWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (CSVExporter{}).Write(&buf, sampleRecords(), Stats{}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "Coffee") || !strings.Contains(out, "40.00") {
+		t.Fatalf("unexpected csv output: %s", out)
+	}
+}
+
+func TestJSONExporterWrite(t *testing.T) {
+	var buf bytes.Buffer
+	stats := Stats{CategoryTotals: map[string]float64{"Food": 5}}
+	if err := (JSONExporter{}).Write(&buf, sampleRecords(), stats); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `"expenses"`) || !strings.Contains(out, `"Food": 5`) {
+		t.Fatalf("unexpected json output: %s", out)
+	}
+}
+
+func TestLedgerExporterWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (LedgerExporter{}).Write(&buf, sampleRecords(), Stats{}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "2025-08-01  Coffee") || !strings.Contains(out, "CASH  -5.00") || !strings.Contains(out, "Expenses:Food  5.00") {
+		t.Fatalf("unexpected ledger output: %s", out)
+	}
+}
+
+func TestLedgerExporterWriteTransferIsBalanced(t *testing.T) {
+	records := []Record{
+		{ID: 1, Date: "2025-08-15", Description: "Transfer to CASH", Account: "CHECKING", Amount: 50, IsTransfer: true, TransferID: 1},
+		{ID: 2, Date: "2025-08-15", Description: "Transfer from CHECKING", Account: "CASH", Amount: -50, IsTransfer: true, TransferID: 1},
+	}
+	var buf bytes.Buffer
+	if err := (LedgerExporter{}).Write(&buf, records, Stats{}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected one transaction (1 header + 2 postings), got %d lines: %q", len(lines), out)
+	}
+	if !strings.Contains(out, "CHECKING  -50.00") || !strings.Contains(out, "CASH  50.00") {
+		t.Fatalf("expected both balanced legs as postings of the same transaction: %s", out)
+	}
+}
+
+func TestXLSXExporterWrite(t *testing.T) {
+	var buf bytes.Buffer
+	stats := Stats{
+		CategoryTotals: map[string]float64{"Food": 5, "Auto": 40},
+		Budgets:        []BudgetLine{{Month: "2025-08", Budget: 100, Spent: 45}},
+	}
+	if err := (XLSXExporter{}).Write(&buf, sampleRecords(), stats); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	f, err := xlsx.OpenBinary(buf.Bytes())
+	if err != nil {
+		t.Fatalf("re-open xlsx: %v", err)
+	}
+	if f.Sheet["2025-08"] == nil {
+		t.Fatalf("expected a 2025-08 sheet, got sheets: %v", f.Sheets)
+	}
+	if f.Sheet["Summary"] == nil || f.Sheet["Budgets"] == nil {
+		t.Fatalf("expected Summary and Budgets sheets, got: %v", f.Sheets)
+	}
+}
+
+func TestHTMLExporterWrite(t *testing.T) {
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	stats := Stats{
+		CategoryTotals: map[string]float64{"Food": 5, "Auto": 40},
+		MonthlyTotals:  map[string]float64{"2025-08": 45},
+	}
+	if err := (HTMLExporter{OutputDir: dir}).Write(&buf, sampleRecords(), stats); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `src="`+chartFileName+`"`) {
+		t.Fatalf("expected chart image reference: %s", out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, chartFileName)); err != nil {
+		t.Fatalf("expected chart file to be written: %v", err)
+	}
+}
+
+// TestHTMLExporterWriteNoData covers a brand-new store or a filter that
+// matches nothing: MonthlyTotals is empty, and the export must still
+// succeed instead of propagating plotter's "no data points" error.
+func TestHTMLExporterWriteNoData(t *testing.T) {
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	if err := (HTMLExporter{OutputDir: dir}).Write(&buf, nil, Stats{}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "<img") {
+		t.Fatalf("expected no chart image when there is no data: %s", out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, chartFileName)); err == nil {
+		t.Fatalf("expected no chart file to be written")
+	}
+}
+
+func TestNewInfersFormatFromExtension(t *testing.T) {
+	exp, err := New("", "report.json")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, ok := exp.(JSONExporter); !ok {
+		t.Fatalf("expected JSONExporter, got %T", exp)
+	}
+}
@@ -0,0 +1,91 @@
+package exporters
+
+import (
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// HTMLExporter renders a self-contained report: an embedded bar chart of
+// monthly totals plus a category breakdown table. The chart is written as
+// a PNG alongside the HTML file (in OutputDir) and referenced by filename.
+type HTMLExporter struct {
+	OutputDir string
+}
+
+const chartFileName = "monthly-totals.png"
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Expense Report</title></head>
+<body>
+<h1>Expense Report</h1>
+{{if .ChartFile}}<img src="{{.ChartFile}}" alt="Monthly totals">{{else}}<p>No data available for chart.</p>{{end}}
+<h2>Category totals</h2>
+<table border="1" cellpadding="4">
+<tr><th>Category</th><th>Total</th></tr>
+{{range .Categories}}<tr><td>{{.Name}}</td><td>${{printf "%.2f" .Total}}</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+type categoryRow struct {
+	Name  string
+	Total float64
+}
+
+func (h HTMLExporter) Write(w io.Writer, _ []Record, stats Stats) error {
+	// A brand-new store or a filter that matches nothing leaves
+	// MonthlyTotals empty; plotter.NewBarChart rejects a zero-length
+	// series, so skip the chart entirely rather than failing the export.
+	chartFile := ""
+	if len(stats.MonthlyTotals) > 0 {
+		if err := writeMonthlyChart(filepath.Join(h.OutputDir, chartFileName), stats.MonthlyTotals); err != nil {
+			return err
+		}
+		chartFile = chartFileName
+	}
+
+	cats := make([]categoryRow, 0, len(stats.CategoryTotals))
+	for name, total := range stats.CategoryTotals {
+		cats = append(cats, categoryRow{Name: name, Total: total})
+	}
+	sort.Slice(cats, func(i, j int) bool { return cats[i].Name < cats[j].Name })
+
+	return htmlReportTemplate.Execute(w, struct {
+		ChartFile  string
+		Categories []categoryRow
+	}{ChartFile: chartFile, Categories: cats})
+}
+
+func writeMonthlyChart(path string, monthlyTotals map[string]float64) error {
+	months := make([]string, 0, len(monthlyTotals))
+	for mk := range monthlyTotals {
+		months = append(months, mk)
+	}
+	sort.Strings(months)
+
+	values := make(plotter.Values, len(months))
+	for i, mk := range months {
+		values[i] = monthlyTotals[mk]
+	}
+
+	p := plot.New()
+	p.Title.Text = "Monthly totals"
+
+	bars, err := plotter.NewBarChart(values, vg.Points(20))
+	if err != nil {
+		return err
+	}
+	p.Add(bars)
+	p.NominalX(months...)
+
+	return p.Save(6*vg.Inch, 4*vg.Inch, path)
+}
@@ -0,0 +1,18 @@
+package exporters
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONExporter writes the filtered records plus their aggregate stats.
+type JSONExporter struct{}
+
+func (JSONExporter) Write(w io.Writer, records []Record, stats Stats) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Expenses []Record `json:"expenses"`
+		Stats    Stats    `json:"stats"`
+	}{Expenses: records, Stats: stats})
+}
@@ -0,0 +1,48 @@
+package exporters
+
+import (
+	"fmt"
+	"io"
+)
+
+// LedgerExporter writes a plain-text journal in the `date  payee` / indented
+// postings format consumed by `expense-tracker import --format ledger`.
+// Categories round-trip as "Expenses:<Category>" sub-accounts.
+type LedgerExporter struct{}
+
+func (LedgerExporter) Write(w io.Writer, records []Record, _ Stats) error {
+	emitted := map[int]bool{}
+	for i, r := range records {
+		if r.IsTransfer {
+			if emitted[r.TransferID] {
+				continue
+			}
+			emitted[r.TransferID] = true
+			fmt.Fprintf(w, "%s  %s\n", r.Date, r.Description)
+			fmt.Fprintf(w, "    %s  %.2f\n", r.Account, -r.Amount)
+			// A transfer is two linked legs (debit + credit); write both
+			// as postings of one balanced transaction so the exported
+			// file is accepted by `import --format ledger`, which
+			// requires exactly 2 postings per transaction. If the other
+			// leg was filtered out of records, fall back to the single
+			// posting rather than dropping the row.
+			if partner, ok := findTransferPartner(records, i, r.TransferID); ok {
+				fmt.Fprintf(w, "    %s  %.2f\n", partner.Account, -partner.Amount)
+			}
+			continue
+		}
+		fmt.Fprintf(w, "%s  %s\n", r.Date, r.Description)
+		fmt.Fprintf(w, "    %s  %.2f\n", r.Account, -r.Amount)
+		fmt.Fprintf(w, "    Expenses:%s  %.2f\n", r.Category, r.Amount)
+	}
+	return nil
+}
+
+func findTransferPartner(records []Record, idx, transferID int) (Record, bool) {
+	for i, r := range records {
+		if i != idx && r.IsTransfer && r.TransferID == transferID {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
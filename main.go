@@ -1,7 +1,7 @@
 package main
 
 import (
-	"encoding/csv"
+	"bufio"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -9,10 +9,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/Khoa-Trinh/expense-tracker-cli/exporters"
 )
 
 // ===== Data structures =====
@@ -23,14 +26,44 @@ type Expense struct {
 	Description string    `json:"description"`
 	Amount      float64   `json:"amount"`
 	Category    string    `json:"category"`
+	Account     string    `json:"account,omitempty"`
+	Payee       string    `json:"payee,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+	IsTransfer  bool      `json:"is_transfer,omitempty"`
+	TransferID  int       `json:"transfer_id,omitempty"` // links the two legs of a transfer
+	RecurringID int       `json:"recurring_id,omitempty"`
+	RecurringMk string    `json:"recurring_month,omitempty"` // YYYY-MM this instance was materialized for
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// Account is a ledger account (e.g. CASH, CHECKING, CREDIT) with an opening balance.
+type Account struct {
+	Name    string  `json:"name"`
+	Opening float64 `json:"opening"`
+}
+
+// Recurring is a fixed monthly item (rent, subscriptions, ...) that gets
+// materialized into real Expense records by cmdRecurringApply.
+type Recurring struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Amount   float64 `json:"amount"`
+	Day      int     `json:"day"`              // day-of-month to post on
+	Months   []int   `json:"months,omitempty"`  // 1-12, empty means every month
+	Category string  `json:"category"`
+	Method   string  `json:"method,omitempty"`
+	Account  string  `json:"account,omitempty"`
+}
+
 type Store struct {
-	NextID   int                `json:"next_id"`
-	Expenses []Expense          `json:"expenses"`
-	Budgets  map[string]float64 `json:"budgets"` // key: YYYY-MM
+	NextID          int                `json:"next_id"`
+	NextTransfer    int                `json:"next_transfer_id"`
+	NextRecurringID int                `json:"next_recurring_id"`
+	Expenses        []Expense          `json:"expenses"`
+	Budgets         map[string]float64 `json:"budgets"` // key: YYYY-MM
+	Accounts        []Account          `json:"accounts"`
+	Recurring       []Recurring        `json:"recurring"`
 }
 
 // ===== Constants & paths =====
@@ -41,6 +74,7 @@ const (
 	defaultCat     = "General"
 	dateLayout     = "2006-01-02"
 	monthKeyLayout = "2006-01"
+	defaultAccount = "CASH"
 )
 
 func dataDir() (string, error) {
@@ -74,17 +108,18 @@ func loadStore() (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	f, err := os.Open(path)
-	if errors.Is(err, os.ErrNotExist) {
-		return &Store{NextID: 1, Budgets: map[string]float64{}}, nil
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
 	var st Store
-	if err := json.NewDecoder(f).Decode(&st); err != nil {
+	f, err := os.Open(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		st = Store{NextID: 1, Budgets: map[string]float64{}}
+	case err != nil:
 		return nil, err
+	default:
+		defer f.Close()
+		if err := json.NewDecoder(f).Decode(&st); err != nil {
+			return nil, err
+		}
 	}
 	if st.Budgets == nil {
 		st.Budgets = map[string]float64{}
@@ -92,9 +127,27 @@ func loadStore() (*Store, error) {
 	if st.NextID == 0 {
 		st.NextID = 1
 	}
+	if st.NextRecurringID == 0 {
+		st.NextRecurringID = 1
+	}
+	migrateAccounts(&st)
 	return &st, nil
 }
 
+// migrateAccounts upgrades pre-accounts JSON: it ensures a default CASH
+// account exists and backfills it onto any expense that predates the
+// account field.
+func migrateAccounts(st *Store) {
+	if len(st.Accounts) == 0 {
+		st.Accounts = []Account{{Name: defaultAccount}}
+	}
+	for i := range st.Expenses {
+		if strings.TrimSpace(st.Expenses[i].Account) == "" {
+			st.Expenses[i].Account = defaultAccount
+		}
+	}
+}
+
 func (st *Store) save() error {
 	if err := ensureDir(); err != nil {
 		return err
@@ -145,6 +198,9 @@ func monthKeyFromDate(date string) (string, error) {
 func (st *Store) sumForMonth(key string, category string) float64 {
 	total := 0.0
 	for _, e := range st.Expenses {
+		if e.IsTransfer {
+			continue
+		}
 		mk, err := monthKeyFromDate(e.Date)
 		if err != nil {
 			continue
@@ -165,9 +221,39 @@ func (st *Store) findByID(id int) (*Expense, int) {
 	return nil, -1
 }
 
+func (st *Store) findAccount(name string) (*Account, int) {
+	for i := range st.Accounts {
+		if strings.EqualFold(st.Accounts[i].Name, name) {
+			return &st.Accounts[i], i
+		}
+	}
+	return nil, -1
+}
+
+// balanceAt returns the running balance of account name as of (and
+// including) date, computed as its opening balance minus every expense
+// and transfer leg posted against it on or before that date.
+func (st *Store) balanceAt(name string, date string) (float64, error) {
+	acct, idx := st.findAccount(name)
+	if idx < 0 {
+		return 0, fmt.Errorf("unknown account %q", name)
+	}
+	bal := acct.Opening
+	for _, e := range st.Expenses {
+		if !strings.EqualFold(e.Account, name) {
+			continue
+		}
+		if e.Date > date {
+			continue
+		}
+		bal -= e.Amount
+	}
+	return bal, nil
+}
+
 func printTable(expenses []Expense, w io.Writer) {
-	fmt.Fprintln(w, "# ID  Date        Description                      Category        Amount")
-	fmt.Fprintln(w, "# --- ----------- -------------------------------- ---------------- ---------")
+	fmt.Fprintln(w, "# ID  Date        Description                      Category        Account    Amount")
+	fmt.Fprintln(w, "# --- ----------- -------------------------------- ---------------- ---------- ---------")
 	for _, e := range expenses {
 		desc := e.Description
 		if len(desc) > 32 {
@@ -177,7 +263,11 @@ func printTable(expenses []Expense, w io.Writer) {
 		if len(cat) > 14 {
 			cat = cat[:11] + "..."
 		}
-		fmt.Fprintf(w, "# %-3d %-11s %-32s %-14s $%.2f\n", e.ID, e.Date, desc, cat, e.Amount)
+		acct := e.Account
+		if acct == "" {
+			acct = defaultAccount
+		}
+		fmt.Fprintf(w, "# %-3d %-11s %-32s %-14s %-10s $%.2f\n", e.ID, e.Date, desc, cat, acct, e.Amount)
 	}
 }
 
@@ -204,6 +294,7 @@ func cmdAdd(args []string) error {
 	amount := fs.Float64("amount", 0, "expense amount (required, > 0)")
 	date := fs.String("date", "", "date in YYYY-MM-DD (default: today)")
 	cat := fs.String("category", defaultCat, "category name")
+	account := fs.String("account", defaultAccount, "account to post the expense against")
 	fs.Parse(args)
 
 	if strings.TrimSpace(*desc) == "" {
@@ -221,7 +312,11 @@ func cmdAdd(args []string) error {
 	if err != nil {
 		return err
 	}
-	e := Expense{ID: st.NextID, Date: d, Description: strings.TrimSpace(*desc), Amount: *amount, Category: strings.TrimSpace(*cat), CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	acctName := strings.TrimSpace(*account)
+	if _, idx := st.findAccount(acctName); idx < 0 {
+		return fmt.Errorf("unknown account %q", acctName)
+	}
+	e := Expense{ID: st.NextID, Date: d, Description: strings.TrimSpace(*desc), Amount: *amount, Category: strings.TrimSpace(*cat), Account: acctName, CreatedAt: time.Now(), UpdatedAt: time.Now()}
 	st.Expenses = append(st.Expenses, e)
 	st.NextID++
 	if err := st.save(); err != nil {
@@ -257,6 +352,7 @@ func cmdUpdate(args []string) error {
 	fs.Var(&amt, "amount", "new amount (number > 0)")
 	date := fs.String("date", "", "new date (YYYY-MM-DD)")
 	cat := fs.String("category", "", "new category")
+	account := fs.String("account", "", "new account")
 	fs.Parse(args)
 	if *id <= 0 {
 		return errors.New("--id is required")
@@ -289,6 +385,13 @@ func cmdUpdate(args []string) error {
 	if strings.TrimSpace(*cat) != "" {
 		e.Category = strings.TrimSpace(*cat)
 	}
+	if strings.TrimSpace(*account) != "" {
+		acctName := strings.TrimSpace(*account)
+		if _, idx := st.findAccount(acctName); idx < 0 {
+			return fmt.Errorf("unknown account %q", acctName)
+		}
+		e.Account = acctName
+	}
 	e.UpdatedAt = time.Now()
 
 	if err := st.save(); err != nil {
@@ -323,25 +426,12 @@ func cmdDelete(args []string) error {
 	return nil
 }
 
-func filterExpenses(st *Store, month int, year int, category string) []Expense {
+func filterExpenses(st *Store, q QueryFilter) []Expense {
 	var out []Expense
 	for _, e := range st.Expenses {
-		if category != "" && !strings.EqualFold(category, e.Category) {
-			continue
-		}
-		if month > 0 || year > 0 {
-			t, err := time.Parse(dateLayout, e.Date)
-			if err != nil {
-				continue
-			}
-			if year > 0 && t.Year() != year {
-				continue
-			}
-			if month > 0 && int(t.Month()) != month {
-				continue
-			}
+		if q.Match(e) {
+			out = append(out, e)
 		}
-		out = append(out, e)
 	}
 	sort.Slice(out, func(i, j int) bool {
 		return out[i].Date < out[j].Date || (out[i].Date == out[j].Date && out[i].ID < out[j].ID)
@@ -354,6 +444,7 @@ func cmdList(args []string) error {
 	category := fs.String("category", "", "filter by category (case-insensitive)")
 	month := fs.Int("month", 0, "filter by month (1-12) of current year or with --year")
 	year := fs.Int("year", 0, "filter by year (e.g., 2025). default: all years or current when --month is set")
+	qf := registerQueryFlags(fs)
 	fs.Parse(args)
 
 	st, err := loadStore()
@@ -366,7 +457,11 @@ func cmdList(args []string) error {
 	if *month > 0 && *year == 0 {
 		*year = time.Now().Year()
 	}
-	items := filterExpenses(st, *month, *year, strings.TrimSpace(*category))
+	q, err := qf.build(strings.TrimSpace(*category), *month, *year)
+	if err != nil {
+		return err
+	}
+	items := filterExpenses(st, q)
 	printTable(items, os.Stdout)
 	return nil
 }
@@ -375,19 +470,36 @@ func cmdSummary(args []string) error {
 	fs := flag.NewFlagSet("summary", flag.ExitOnError)
 	month := fs.Int("month", 0, "month (1-12) of current year")
 	category := fs.String("category", "", "filter by category")
+	qf := registerQueryFlags(fs)
 	fs.Parse(args)
 
 	st, err := loadStore()
 	if err != nil {
 		return err
 	}
-	if *month == 0 {
-		total := 0.0
-		for _, e := range st.Expenses {
-			if *category == "" || strings.EqualFold(*category, e.Category) {
-				total += e.Amount
-			}
+	if *month != 0 && (*month < 1 || *month > 12) {
+		return errors.New("--month must be 1-12")
+	}
+	year := 0
+	if *month > 0 {
+		year = time.Now().Year()
+	}
+	q, err := qf.build(strings.TrimSpace(*category), *month, year)
+	if err != nil {
+		return err
+	}
+
+	total := 0.0
+	for _, e := range st.Expenses {
+		if e.IsTransfer {
+			continue
 		}
+		if q.Match(e) {
+			total += e.Amount
+		}
+	}
+
+	if *month == 0 {
 		if *category == "" {
 			fmt.Printf("# Total expenses: $%.2f\n", total)
 		} else {
@@ -395,12 +507,6 @@ func cmdSummary(args []string) error {
 		}
 		return nil
 	}
-	if *month < 1 || *month > 12 {
-		return errors.New("--month must be 1-12")
-	}
-	year := time.Now().Year()
-	mk := fmt.Sprintf("%04d-%02d", year, *month)
-	total := st.sumForMonth(mk, strings.TrimSpace(*category))
 	monName := time.Month(*month).String()
 	if strings.TrimSpace(*category) == "" {
 		fmt.Printf("# Total expenses for %s: $%.2f\n", monName, total)
@@ -453,10 +559,12 @@ func cmdBudget(args []string) error {
 
 func cmdExport(args []string) error {
 	fs := flag.NewFlagSet("export", flag.ExitOnError)
-	out := fs.String("output", "expenses.csv", "output CSV file path")
+	out := fs.String("output", "expenses.csv", "output file path")
+	format := fs.String("format", "", "output format (csv, json, ledger, xlsx, html); inferred from --output if omitted")
 	category := fs.String("category", "", "filter by category")
 	month := fs.Int("month", 0, "filter month (1-12) of current year or with --year")
 	year := fs.Int("year", 0, "filter year")
+	qf := registerQueryFlags(fs)
 	fs.Parse(args)
 
 	st, err := loadStore()
@@ -469,81 +577,608 @@ func cmdExport(args []string) error {
 	if *month > 0 && *year == 0 {
 		*year = time.Now().Year()
 	}
-	items := filterExpenses(st, *month, *year, strings.TrimSpace(*category))
+	q, err := qf.build(strings.TrimSpace(*category), *month, *year)
+	if err != nil {
+		return err
+	}
+	items := filterExpenses(st, q)
+
+	exp, err := exporters.New(*format, *out)
+	if err != nil {
+		return err
+	}
 
 	f, err := os.Create(*out)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
-	w := csv.NewWriter(f)
-	defer w.Flush()
-	_ = w.Write([]string{"id", "date", "description", "category", "amount"})
+
+	if err := exp.Write(f, toExportRecords(items), buildExportStats(st, items)); err != nil {
+		return err
+	}
+	fmt.Printf("# Exported %d rows to %s\n", len(items), *out)
+	return nil
+}
+
+func toExportRecords(items []Expense) []exporters.Record {
+	out := make([]exporters.Record, len(items))
+	for i, e := range items {
+		out[i] = exporters.Record{
+			ID: e.ID, Date: e.Date, Description: e.Description,
+			Category: e.Category, Account: e.Account, Amount: e.Amount,
+			IsTransfer: e.IsTransfer, TransferID: e.TransferID,
+		}
+	}
+	return out
+}
+
+// buildExportStats computes the per-category and per-month totals, plus
+// budget-vs-spent lines, that the richer export formats render.
+func buildExportStats(st *Store, items []Expense) exporters.Stats {
+	stats := exporters.Stats{
+		CategoryTotals: map[string]float64{},
+		MonthlyTotals:  map[string]float64{},
+	}
 	for _, e := range items {
-		_ = w.Write([]string{strconv.Itoa(e.ID), e.Date, e.Description, e.Category, fmt.Sprintf("%.2f", e.Amount)})
+		if e.IsTransfer {
+			continue
+		}
+		stats.CategoryTotals[e.Category] += e.Amount
+		if mk, err := monthKeyFromDate(e.Date); err == nil {
+			stats.MonthlyTotals[mk] += e.Amount
+		}
+	}
+
+	months := make([]string, 0, len(st.Budgets))
+	for mk := range st.Budgets {
+		months = append(months, mk)
+	}
+	sort.Strings(months)
+	for _, mk := range months {
+		stats.Budgets = append(stats.Budgets, exporters.BudgetLine{
+			Month: mk, Budget: st.Budgets[mk], Spent: st.sumForMonth(mk, ""),
+		})
+	}
+	return stats
+}
+
+// ===== Accounts, transfers & ledger import/export =====
+
+func cmdAccount(args []string) error {
+	if len(args) == 0 {
+		return errors.New("expected a subcommand: add, list")
+	}
+	sub, rest := args[0], args[1:]
+	switch sub {
+	case "add":
+		return cmdAccountAdd(rest)
+	case "list":
+		return cmdAccountList(rest)
+	default:
+		return fmt.Errorf("unknown account subcommand %q (expected add, list)", sub)
+	}
+}
+
+func cmdAccountAdd(args []string) error {
+	fs := flag.NewFlagSet("account add", flag.ExitOnError)
+	name := fs.String("name", "", "account name (required, e.g. CHECKING)")
+	opening := fs.Float64("opening", 0, "opening balance")
+	fs.Parse(args)
+
+	n := strings.TrimSpace(*name)
+	if n == "" {
+		return errors.New("--name is required")
+	}
+
+	st, err := loadStore()
+	if err != nil {
+		return err
 	}
-	if err := w.Error(); err != nil {
+	if _, idx := st.findAccount(n); idx >= 0 {
+		return fmt.Errorf("account %q already exists", n)
+	}
+	st.Accounts = append(st.Accounts, Account{Name: n, Opening: *opening})
+	if err := st.save(); err != nil {
 		return err
 	}
-	fmt.Printf("# Exported %d rows to %s\n", len(items), *out)
+	fmt.Printf("# Account %s added (opening balance $%.2f)\n", n, *opening)
 	return nil
 }
 
-// ===== CLI scaffolding =====
+func cmdAccountList(args []string) error {
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+	fmt.Println("# Name            Opening")
+	fmt.Println("# --------------- ---------")
+	for _, a := range st.Accounts {
+		fmt.Printf("# %-15s $%.2f\n", a.Name, a.Opening)
+	}
+	return nil
+}
+
+func cmdTransfer(args []string) error {
+	fs := flag.NewFlagSet("transfer", flag.ExitOnError)
+	from := fs.String("from", "", "source account (required)")
+	to := fs.String("to", "", "destination account (required)")
+	amount := fs.Float64("amount", 0, "transfer amount (required, > 0)")
+	date := fs.String("date", "", "date in YYYY-MM-DD (default: today)")
+	notes := fs.String("notes", "", "optional notes")
+	fs.Parse(args)
+
+	fromName, toName := strings.TrimSpace(*from), strings.TrimSpace(*to)
+	if fromName == "" || toName == "" {
+		return errors.New("--from and --to are required")
+	}
+	if strings.EqualFold(fromName, toName) {
+		return errors.New("--from and --to must differ")
+	}
+	if *amount <= 0 {
+		return errors.New("--amount must be > 0")
+	}
+	d, err := parseDateOrToday(*date)
+	if err != nil {
+		return err
+	}
+
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+	if _, idx := st.findAccount(fromName); idx < 0 {
+		return fmt.Errorf("unknown account %q", fromName)
+	}
+	if _, idx := st.findAccount(toName); idx < 0 {
+		return fmt.Errorf("unknown account %q", toName)
+	}
 
-func usage() {
-	fmt.Printf("%s â€” simple CLI expense tracker\n\n", appName)
-	fmt.Println("Usage:")
-	fmt.Println("  expense-tracker <command> [options]")
-	fmt.Println("Commands:")
-	fmt.Println("  add         --description <text> --amount <number> [--date YYYY-MM-DD] [--category NAME]")
-	fmt.Println("  update      --id <n> [--description <text>] [--amount <number>] [--date YYYY-MM-DD] [--category NAME]")
-	fmt.Println("  delete      --id <n>")
-	fmt.Println("  list        [--category NAME] [--month 1-12] [--year YYYY]")
-	fmt.Println("  summary     [--month 1-12] [--category NAME]")
-	fmt.Println("  budget      [--set <number>] [--month 1-12] [--year YYYY]")
-	fmt.Println("  export      [--output FILE] [--category NAME] [--month 1-12] [--year YYYY]")
-	fmt.Println("")
-	fmt.Println("Examples:")
-	fmt.Println("  expense-tracker add --description \"Lunch\" --amount 20")
-	fmt.Println("  expense-tracker list")
-	fmt.Println("  expense-tracker summary --month 8")
-	fmt.Println("  expense-tracker budget --set 500 --month 8 --year 2025")
-	fmt.Println("  expense-tracker export --output my-expenses.csv --month 8")
+	st.NextTransfer++
+	tid := st.NextTransfer
+	now := time.Now()
+	debit := Expense{
+		ID: st.NextID, Date: d, Description: fmt.Sprintf("Transfer to %s", toName),
+		Amount: *amount, Category: "Transfer", Account: fromName, Notes: strings.TrimSpace(*notes),
+		IsTransfer: true, TransferID: tid, CreatedAt: now, UpdatedAt: now,
+	}
+	st.NextID++
+	credit := Expense{
+		ID: st.NextID, Date: d, Description: fmt.Sprintf("Transfer from %s", fromName),
+		Amount: -*amount, Category: "Transfer", Account: toName, Notes: strings.TrimSpace(*notes),
+		IsTransfer: true, TransferID: tid, CreatedAt: now, UpdatedAt: now,
+	}
+	st.NextID++
+	st.Expenses = append(st.Expenses, debit, credit)
+	if err := st.save(); err != nil {
+		return err
+	}
+	fmt.Printf("# Transferred $%.2f from %s to %s\n", *amount, fromName, toName)
+	return nil
 }
 
-func main() {
-	if len(os.Args) < 2 {
-		usage()
-		return
+func cmdBalance(args []string) error {
+	fs := flag.NewFlagSet("balance", flag.ExitOnError)
+	at := fs.String("at", "", "as-of date in YYYY-MM-DD (default: today)")
+	account := fs.String("account", "", "only show this account")
+	fs.Parse(args)
+
+	d, err := parseDateOrToday(*at)
+	if err != nil {
+		return err
+	}
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("# Balances as of %s\n", d)
+	fmt.Println("# Name            Balance")
+	fmt.Println("# --------------- ---------")
+	for _, a := range st.Accounts {
+		if *account != "" && !strings.EqualFold(*account, a.Name) {
+			continue
+		}
+		bal, err := st.balanceAt(a.Name, d)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("# %-15s $%.2f\n", a.Name, bal)
+	}
+	return nil
+}
+
+// ledgerTxn is one parsed `date  payee` block with its postings.
+type ledgerTxn struct {
+	date     string
+	payee    string
+	postings []ledgerPosting
+}
+
+type ledgerPosting struct {
+	account string
+	amount  float64
+}
+
+var ledgerDateRe = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})\s+(.*)$`)
+
+func parseLedger(r io.Reader) ([]ledgerTxn, error) {
+	var txns []ledgerTxn
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if m := ledgerDateRe.FindStringSubmatch(line); m != nil {
+			txns = append(txns, ledgerTxn{date: m[1], payee: strings.TrimSpace(m[2])})
+			continue
+		}
+		if len(txns) == 0 || !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		amt, err := strconv.ParseFloat(fields[len(fields)-1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid posting amount in %q: %v", line, err)
+		}
+		acct := strings.Join(fields[:len(fields)-1], " ")
+		cur := &txns[len(txns)-1]
+		cur.postings = append(cur.postings, ledgerPosting{account: acct, amount: amt})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// ledgerToExpenses converts parsed transactions into Expense records. Each
+// transaction must have exactly 2 postings, and the account of the leg with
+// the negative amount must be a known account. The positive leg gives the
+// amount, and its account with any "Expenses:" prefix removed becomes the
+// category; it is not required to be an "Expenses:" sub-account, so a
+// transaction between two real accounts is imported as an ordinary expense.
+func ledgerToExpenses(st *Store, txns []ledgerTxn, startID int) ([]Expense, error) {
+	var out []Expense
+	nextID := startID
+	now := time.Now()
+	for _, t := range txns {
+		if len(t.postings) != 2 {
+			return nil, fmt.Errorf("transaction %s %s: expected exactly 2 postings, got %d", t.date, t.payee, len(t.postings))
+		}
+		a, b := t.postings[0], t.postings[1]
+		if a.amount > 0 {
+			a, b = b, a
+		}
+		account := a.account
+		if _, idx := st.findAccount(account); idx < 0 {
+			return nil, fmt.Errorf("transaction %s %s: unknown account %q", t.date, t.payee, account)
+		}
+		category := strings.TrimPrefix(b.account, "Expenses:")
+		out = append(out, Expense{
+			ID: nextID, Date: t.date, Description: t.payee, Payee: t.payee,
+			Amount: b.amount, Category: category, Account: account,
+			CreatedAt: now, UpdatedAt: now,
+		})
+		nextID++
+	}
+	return out, nil
+}
+
+func cmdImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "ledger", "import format (ledger)")
+	input := fs.String("input", "", "input file path (required)")
+	fs.Parse(args)
+
+	if *format != "ledger" {
+		return fmt.Errorf("unsupported import format %q", *format)
+	}
+	if strings.TrimSpace(*input) == "" {
+		return errors.New("--input is required")
+	}
+
+	f, err := os.Open(*input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	txns, err := parseLedger(f)
+	if err != nil {
+		return err
+	}
+
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+	imported, err := ledgerToExpenses(st, txns, st.NextID)
+	if err != nil {
+		return err
+	}
+	st.Expenses = append(st.Expenses, imported...)
+	st.NextID += len(imported)
+	if err := st.save(); err != nil {
+		return err
+	}
+	fmt.Printf("# Imported %d transactions from %s\n", len(imported), *input)
+	return nil
+}
+
+// ===== Recurring expenses =====
+
+func daysInMonth(year int, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func parseMonthList(s string) ([]int, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var out []int
+	for _, p := range strings.Split(s, ",") {
+		m, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || m < 1 || m > 12 {
+			return nil, fmt.Errorf("invalid month %q in --months", p)
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+func (st *Store) findRecurring(id int) (*Recurring, int) {
+	for i := range st.Recurring {
+		if st.Recurring[i].ID == id {
+			return &st.Recurring[i], i
+		}
 	}
-	cmd := strings.ToLower(os.Args[1])
-	args := os.Args[2:]
-	var err error
-	switch cmd {
+	return nil, -1
+}
+
+func cmdRecurring(args []string) error {
+	if len(args) == 0 {
+		return errors.New("expected a subcommand: add, list, apply")
+	}
+	sub, rest := args[0], args[1:]
+	switch sub {
 	case "add":
-		err = cmdAdd(args)
-	case "update":
-		err = cmdUpdate(args)
-	case "delete":
-		err = cmdDelete(args)
+		return cmdRecurringAdd(rest)
 	case "list":
-		err = cmdList(args)
-	case "summary":
-		err = cmdSummary(args)
-	case "budget":
-		err = cmdBudget(args)
-	case "export":
-		err = cmdExport(args)
-	case "help", "-h", "--help":
-		usage()
-		return
+		return cmdRecurringList(rest)
+	case "apply":
+		return cmdRecurringApply(rest)
 	default:
-		fmt.Printf("Unknown command: %s\n\n", cmd)
-		usage()
-		return
+		return fmt.Errorf("unknown recurring subcommand %q (expected add, list, apply)", sub)
+	}
+}
+
+func cmdRecurringAdd(args []string) error {
+	fs := flag.NewFlagSet("recurring add", flag.ExitOnError)
+	name := fs.String("name", "", "item name (required, e.g. Rent)")
+	amount := fs.Float64("amount", 0, "monthly amount (required, > 0)")
+	day := fs.Int("day", 1, "day of month to post on (1-28)")
+	months := fs.String("months", "", "comma-separated months this applies to (default: every month)")
+	cat := fs.String("category", defaultCat, "category name")
+	method := fs.String("method", "", "payment method")
+	account := fs.String("account", defaultAccount, "account to post against")
+	fs.Parse(args)
+
+	n := strings.TrimSpace(*name)
+	if n == "" {
+		return errors.New("--name is required")
+	}
+	if *amount <= 0 {
+		return errors.New("--amount must be > 0")
+	}
+	if *day < 1 || *day > 28 {
+		return errors.New("--day must be 1-28")
+	}
+	ms, err := parseMonthList(*months)
+	if err != nil {
+		return err
+	}
+
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+	acctName := strings.TrimSpace(*account)
+	if _, idx := st.findAccount(acctName); idx < 0 {
+		return fmt.Errorf("unknown account %q", acctName)
+	}
+	if st.NextRecurringID < 1 {
+		// 0 means "not recurring" in Expense.RecurringID, so a real
+		// Recurring must never be assigned ID 0.
+		st.NextRecurringID = 1
+	}
+	r := Recurring{
+		ID: st.NextRecurringID, Name: n, Amount: *amount, Day: *day,
+		Months: ms, Category: strings.TrimSpace(*cat), Method: strings.TrimSpace(*method),
+		Account: acctName,
+	}
+	st.Recurring = append(st.Recurring, r)
+	st.NextRecurringID++
+	if err := st.save(); err != nil {
+		return err
+	}
+	fmt.Printf("# Recurring item added successfully (ID: %d)\n", r.ID)
+	return nil
+}
+
+func cmdRecurringList(args []string) error {
+	st, err := loadStore()
+	if err != nil {
+		return err
 	}
+	fmt.Println("# ID  Name                 Amount    Day  Months          Category")
+	fmt.Println("# --- -------------------- --------- ---- --------------- ----------------")
+	for _, r := range st.Recurring {
+		months := "all"
+		if len(r.Months) > 0 {
+			parts := make([]string, len(r.Months))
+			for i, m := range r.Months {
+				parts[i] = strconv.Itoa(m)
+			}
+			months = strings.Join(parts, ",")
+		}
+		fmt.Printf("# %-3d %-20s $%-8.2f %-4d %-15s %-16s\n", r.ID, r.Name, r.Amount, r.Day, months, r.Category)
+	}
+	return nil
+}
+
+func cmdRecurringApply(args []string) error {
+	fs := flag.NewFlagSet("recurring apply", flag.ExitOnError)
+	month := fs.Int("month", 0, "month (1-12), default: current month")
+	year := fs.Int("year", 0, "year, default: current year")
+	fs.Parse(args)
+
+	if *month == 0 {
+		*month = int(time.Now().Month())
+	}
+	if *year == 0 {
+		*year = time.Now().Year()
+	}
+	if *month < 1 || *month > 12 {
+		return errors.New("--month must be 1-12")
+	}
+	mk := fmt.Sprintf("%04d-%02d", *year, *month)
+
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+
+	applied := make(map[int]bool)
+	for _, e := range st.Expenses {
+		if e.RecurringID != 0 && e.RecurringMk == mk {
+			applied[e.RecurringID] = true
+		}
+	}
+
+	count := 0
+	now := time.Now()
+	for _, r := range st.Recurring {
+		if applied[r.ID] {
+			continue
+		}
+		if len(r.Months) > 0 {
+			found := false
+			for _, m := range r.Months {
+				if m == *month {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		day := r.Day
+		if max := daysInMonth(*year, *month); day > max {
+			day = max
+		}
+		e := Expense{
+			ID: st.NextID, Date: fmt.Sprintf("%s-%02d", mk, day), Description: r.Name,
+			Amount: r.Amount, Category: r.Category, Account: r.Account,
+			RecurringID: r.ID, RecurringMk: mk, CreatedAt: now, UpdatedAt: now,
+		}
+		st.Expenses = append(st.Expenses, e)
+		st.NextID++
+		count++
+	}
+	if err := st.save(); err != nil {
+		return err
+	}
+	fmt.Printf("# Applied %d recurring item(s) for %s\n", count, mk)
+	return nil
+}
+
+func cmdDaily(args []string) error {
+	fs := flag.NewFlagSet("daily", flag.ExitOnError)
+	month := fs.Int("month", 0, "month (1-12), default: current month")
+	year := fs.Int("year", 0, "year, default: current year")
+	fs.Parse(args)
+
+	if *month == 0 {
+		*month = int(time.Now().Month())
+	}
+	if *year == 0 {
+		*year = time.Now().Year()
+	}
+	if *month < 1 || *month > 12 {
+		return errors.New("--month must be 1-12")
+	}
+	mk := fmt.Sprintf("%04d-%02d", *year, *month)
+
+	st, err := loadStore()
+	if err != nil {
+		return err
+	}
+	total := st.sumForMonth(mk, "")
+	days := daysInMonth(*year, *month)
+	fmt.Printf("# Daily amortized cost for %s: $%.2f (total $%.2f / %d days)\n", mk, total/float64(days), total, days)
+	return nil
+}
+
+func cmdShow(args []string) error {
+	if len(args) == 0 {
+		return errors.New("expected a month argument, e.g. show 2025-08")
+	}
+	mk := args[0]
+	if _, err := time.Parse(monthKeyLayout, mk); err != nil {
+		return fmt.Errorf("invalid month %q, expected YYYY-MM", mk)
+	}
+
+	st, err := loadStore()
 	if err != nil {
+		return err
+	}
+	totals := map[string]float64{}
+	grand := 0.0
+	for _, e := range st.Expenses {
+		if e.IsTransfer {
+			continue
+		}
+		em, err := monthKeyFromDate(e.Date)
+		if err != nil || em != mk {
+			continue
+		}
+		totals[e.Category] += e.Amount
+		grand += e.Amount
+	}
+
+	cats := make([]string, 0, len(totals))
+	for c := range totals {
+		cats = append(cats, c)
+	}
+	sort.Strings(cats)
+
+	fmt.Printf("# Summary for %s\n", mk)
+	fmt.Println("# Category        Amount")
+	fmt.Println("# --------------- ---------")
+	for _, c := range cats {
+		fmt.Printf("# %-15s $%.2f\n", c, totals[c])
+	}
+	year, month, _ := parseMonthKey(mk)
+	days := daysInMonth(year, month)
+	fmt.Printf("# Total: $%.2f, Daily: $%.2f (%d days)\n", grand, grand/float64(days), days)
+	return nil
+}
+
+func parseMonthKey(mk string) (year int, month int, err error) {
+	t, err := time.Parse(monthKeyLayout, mk)
+	if err != nil {
+		return 0, 0, err
+	}
+	return t.Year(), int(t.Month()), nil
+}
+
+// ===== CLI scaffolding =====
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
@@ -183,6 +183,240 @@ func TestBudgetSetAndExport(t *testing.T) {
 	}
 }
 
+func TestAccountsTransferAndBalance(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAccount([]string{"add", "--name", "CHECKING", "--opening", "1000"}); err != nil {
+		t.Fatalf("account add: %v", err)
+	}
+	// Default CASH account should already exist via migration.
+	if err := cmdTransfer([]string{"--from", "CHECKING", "--to", "CASH", "--amount", "50", "--date", "2025-08-15"}); err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+
+	out := captureOutput(t, func() {
+		if err := cmdBalance([]string{"--at", "2025-08-31"}); err != nil {
+			t.Fatalf("balance: %v", err)
+		}
+	})
+	if !strings.Contains(out, "CHECKING") || !strings.Contains(out, "$950.00") {
+		t.Fatalf("unexpected CHECKING balance: %s", out)
+	}
+	if !strings.Contains(out, "CASH") || !strings.Contains(out, "$50.00") {
+		t.Fatalf("unexpected CASH balance: %s", out)
+	}
+
+	// A transfer must not count towards cmdSummary totals.
+	out = captureOutput(t, func() {
+		if err := cmdSummary(nil); err != nil {
+			t.Fatalf("summary: %v", err)
+		}
+	})
+	if !strings.Contains(out, "# Total expenses: $0.00") {
+		t.Fatalf("transfer leaked into summary: %s", out)
+	}
+}
+
+func TestAddUpdateAccountAffectsBalance(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAccount([]string{"add", "--name", "CHECKING", "--opening", "1000"}); err != nil {
+		t.Fatalf("account add: %v", err)
+	}
+	if err := cmdAdd([]string{"--description", "Rent", "--amount", "500", "--date", "2025-08-01", "--account", "CHECKING"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := cmdAdd([]string{"--description", "Coffee", "--amount", "5", "--date", "2025-08-02"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	out := captureOutput(t, func() {
+		if err := cmdBalance([]string{"--at", "2025-08-31"}); err != nil {
+			t.Fatalf("balance: %v", err)
+		}
+	})
+	if !strings.Contains(out, "CHECKING") || !strings.Contains(out, "$500.00") {
+		t.Fatalf("expected Rent to debit CHECKING: %s", out)
+	}
+	if !strings.Contains(out, "CASH") || !strings.Contains(out, "$-5.00") {
+		t.Fatalf("expected Coffee to default onto CASH: %s", out)
+	}
+
+	st, err := loadStore()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	coffee, _ := st.findByID(2)
+	if coffee == nil {
+		t.Fatalf("coffee expense not found")
+	}
+	if err := cmdUpdate([]string{"--id", "2", "--account", "CHECKING"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	out = captureOutput(t, func() {
+		if err := cmdBalance([]string{"--at", "2025-08-31", "--account", "CHECKING"}); err != nil {
+			t.Fatalf("balance: %v", err)
+		}
+	})
+	if !strings.Contains(out, "$495.00") {
+		t.Fatalf("expected update to move Coffee onto CHECKING: %s", out)
+	}
+	if err := cmdAdd([]string{"--description", "Bad", "--amount", "1", "--account", "NOPE"}); err == nil {
+		t.Fatalf("expected error for unknown account")
+	}
+}
+
+func TestLedgerImportExport(t *testing.T) {
+	defer withTempHome(t)()
+
+	ledger := "2025-08-01  Landlord\n    CHECKING  -1200.00\n    Expenses:Housing  1200.00\n"
+	path := filepath.Join(t.TempDir(), "journal.ledger")
+	if err := os.WriteFile(path, []byte(ledger), 0o644); err != nil {
+		t.Fatalf("write ledger: %v", err)
+	}
+
+	if err := cmdAccount([]string{"add", "--name", "CHECKING"}); err != nil {
+		t.Fatalf("account add: %v", err)
+	}
+	if err := cmdImport([]string{"--format", "ledger", "--input", path}); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	out := captureOutput(t, func() {
+		if err := cmdList([]string{"--category", "Housing"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	if !strings.Contains(out, "CHECKING") || !strings.Contains(out, "$1200.00") {
+		t.Fatalf("import not reflected in list: %s", out)
+	}
+
+	outPath := filepath.Join(t.TempDir(), "out.ledger")
+	if err := cmdExport([]string{"--output", outPath, "--format", "ledger", "--category", "Housing"}); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	if !strings.Contains(string(data), "Expenses:Housing") {
+		t.Fatalf("unexpected ledger export: %s", data)
+	}
+}
+
+func TestLedgerExportTransferRoundTrips(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAccount([]string{"add", "--name", "CHECKING", "--opening", "1000"}); err != nil {
+		t.Fatalf("account add: %v", err)
+	}
+	if err := cmdTransfer([]string{"--from", "CHECKING", "--to", "CASH", "--amount", "50", "--date", "2025-08-15"}); err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+
+	outPath := filepath.Join(t.TempDir(), "transfer.ledger")
+	if err := cmdExport([]string{"--output", outPath, "--format", "ledger"}); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	if strings.Count(string(data), "\n") != 3 {
+		t.Fatalf("expected a single 3-line transaction (header + 2 postings), got: %q", data)
+	}
+
+	if err := cmdImport([]string{"--format", "ledger", "--input", outPath}); err != nil {
+		t.Fatalf("re-importing the exported transfer failed: %v", err)
+	}
+}
+
+func TestLedgerImportRejectsUnknownAccount(t *testing.T) {
+	defer withTempHome(t)()
+
+	ledger := "2025-08-01  Landlord\n    CHECKING  -1200.00\n    Expenses:Housing  1200.00\n"
+	path := filepath.Join(t.TempDir(), "journal.ledger")
+	if err := os.WriteFile(path, []byte(ledger), 0o644); err != nil {
+		t.Fatalf("write ledger: %v", err)
+	}
+
+	if err := cmdImport([]string{"--format", "ledger", "--input", path}); err == nil {
+		t.Fatalf("expected import to fail for an account that was never created")
+	}
+	st, err := loadStore()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(st.Expenses) != 0 {
+		t.Fatalf("expected no expenses to be imported, got %+v", st.Expenses)
+	}
+}
+
+func TestRecurringApplyIsIdempotent(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdRecurring([]string{"add", "--name", "Rent", "--amount", "1200", "--day", "1", "--category", "Housing"}); err != nil {
+		t.Fatalf("recurring add: %v", err)
+	}
+	if err := cmdRecurring([]string{"apply", "--month", "8", "--year", "2025"}); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if err := cmdRecurring([]string{"apply", "--month", "8", "--year", "2025"}); err != nil {
+		t.Fatalf("re-apply: %v", err)
+	}
+
+	st, err := loadStore()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	count := 0
+	for _, e := range st.Expenses {
+		if e.RecurringID != 0 {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected exactly 1 materialized recurring expense, got %d", count)
+	}
+}
+
+func TestRecurringAddRejectsUnknownAccount(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdRecurring([]string{"add", "--name", "Rent", "--amount", "1200", "--account", "NOPE"}); err == nil {
+		t.Fatalf("expected error for unknown account")
+	}
+	if err := cmdRecurring([]string{"add", "--name", "Rent", "--amount", "1200", "--account", "CASH"}); err != nil {
+		t.Fatalf("recurring add: %v", err)
+	}
+}
+
+func TestDailyAndShowMonth(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAdd([]string{"--description", "Groceries", "--amount", "62", "--date", "2025-08-10", "--category", "Food"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	out := captureOutput(t, func() {
+		if err := cmdDaily([]string{"--month", "8", "--year", "2025"}); err != nil {
+			t.Fatalf("daily: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Daily amortized cost for 2025-08") {
+		t.Fatalf("unexpected daily output: %s", out)
+	}
+
+	out = captureOutput(t, func() {
+		if err := cmdShow([]string{"2025-08"}); err != nil {
+			t.Fatalf("show: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Food") || !strings.Contains(out, "$62.00") {
+		t.Fatalf("unexpected show output: %s", out)
+	}
+}
+
 // helpers
 func strconvI(x int) string { return fmt.Sprintf("%d", x) }
 
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// QueryFilter is the shared predicate used by cmdList, cmdSummary and
+// cmdExport to select which expenses to operate on.
+type QueryFilter struct {
+	Category      string
+	CategoryIn    []string
+	Month         int
+	Year          int
+	Since         string // YYYY-MM-DD, inclusive
+	Until         string // YYYY-MM-DD, inclusive
+	HasAmountGT   bool
+	AmountGT      float64
+	HasAmountLT   bool
+	AmountLT      float64
+	DescriptionRe *regexp.Regexp
+}
+
+// Match reports whether e satisfies every condition set on q. Date bounds
+// are compared lexically on the YYYY-MM-DD string (cheaper than parsing
+// every row with time.Parse) since that format sorts the same lexically
+// and chronologically.
+func (q QueryFilter) Match(e Expense) bool {
+	if q.Category != "" && !strings.EqualFold(q.Category, e.Category) {
+		return false
+	}
+	if len(q.CategoryIn) > 0 {
+		found := false
+		for _, c := range q.CategoryIn {
+			if strings.EqualFold(c, e.Category) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if q.Since != "" && e.Date < q.Since {
+		return false
+	}
+	if q.Until != "" && e.Date > q.Until {
+		return false
+	}
+	if q.HasAmountGT && !(e.Amount > q.AmountGT) {
+		return false
+	}
+	if q.HasAmountLT && !(e.Amount < q.AmountLT) {
+		return false
+	}
+	if q.DescriptionRe != nil && !q.DescriptionRe.MatchString(e.Description) {
+		return false
+	}
+	if q.Month > 0 || q.Year > 0 {
+		t, err := time.Parse(dateLayout, e.Date)
+		if err != nil {
+			return false
+		}
+		if q.Year > 0 && t.Year() != q.Year {
+			return false
+		}
+		if q.Month > 0 && int(t.Month()) != q.Month {
+			return false
+		}
+	}
+	return true
+}
+
+// queryFlags holds the raw flag values for the cross-cutting query DSL
+// (--since, --until, --amount-gt, --amount-lt, --description-re,
+// --category-in), shared across every read command that registers them.
+type queryFlags struct {
+	since         *string
+	until         *string
+	amountGT      floatFlag
+	amountLT      floatFlag
+	descriptionRe *string
+	categoryIn    *string
+}
+
+func registerQueryFlags(fs *flag.FlagSet) *queryFlags {
+	qf := &queryFlags{}
+	qf.since = fs.String("since", "", "only include expenses on/after this date (YYYY-MM-DD)")
+	qf.until = fs.String("until", "", "only include expenses on/before this date (YYYY-MM-DD)")
+	fs.Var(&qf.amountGT, "amount-gt", "only include expenses with amount greater than this value")
+	fs.Var(&qf.amountLT, "amount-lt", "only include expenses with amount less than this value")
+	qf.descriptionRe = fs.String("description-re", "", "only include expenses whose description matches this regex")
+	qf.categoryIn = fs.String("category-in", "", "comma-separated list of categories to include")
+	return qf
+}
+
+// build compiles the flag values into a QueryFilter, layering them on top
+// of the command-specific category/month/year selectors.
+func (qf *queryFlags) build(category string, month, year int) (QueryFilter, error) {
+	q := QueryFilter{Category: category, Month: month, Year: year}
+
+	if s := strings.TrimSpace(*qf.since); s != "" {
+		if _, err := time.Parse(dateLayout, s); err != nil {
+			return q, fmt.Errorf("invalid --since: %v", err)
+		}
+		q.Since = s
+	}
+	if s := strings.TrimSpace(*qf.until); s != "" {
+		if _, err := time.Parse(dateLayout, s); err != nil {
+			return q, fmt.Errorf("invalid --until: %v", err)
+		}
+		q.Until = s
+	}
+	if qf.amountGT.set {
+		q.HasAmountGT = true
+		q.AmountGT = qf.amountGT.val
+	}
+	if qf.amountLT.set {
+		q.HasAmountLT = true
+		q.AmountLT = qf.amountLT.val
+	}
+	if s := strings.TrimSpace(*qf.descriptionRe); s != "" {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return q, fmt.Errorf("invalid --description-re: %v", err)
+		}
+		q.DescriptionRe = re
+	}
+	if s := strings.TrimSpace(*qf.categoryIn); s != "" {
+		for _, c := range strings.Split(s, ",") {
+			q.CategoryIn = append(q.CategoryIn, strings.TrimSpace(c))
+		}
+	}
+	return q, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestQueryFilterMatch(t *testing.T) {
+	e := Expense{Date: "2025-08-15", Amount: 42.50, Category: "Food", Description: "Coffee with Bob"}
+
+	cases := []struct {
+		name string
+		q    QueryFilter
+		want bool
+	}{
+		{"category match", QueryFilter{Category: "food"}, true},
+		{"category mismatch", QueryFilter{Category: "Fuel"}, false},
+		{"category-in match", QueryFilter{CategoryIn: []string{"Fuel", "Food"}}, true},
+		{"category-in mismatch", QueryFilter{CategoryIn: []string{"Fuel", "Auto"}}, false},
+		{"since inclusive", QueryFilter{Since: "2025-08-15"}, true},
+		{"since excludes", QueryFilter{Since: "2025-08-16"}, false},
+		{"until inclusive", QueryFilter{Until: "2025-08-15"}, true},
+		{"until excludes", QueryFilter{Until: "2025-08-14"}, false},
+		{"amount-gt passes", QueryFilter{HasAmountGT: true, AmountGT: 10}, true},
+		{"amount-gt fails", QueryFilter{HasAmountGT: true, AmountGT: 100}, false},
+		{"amount-lt passes", QueryFilter{HasAmountLT: true, AmountLT: 100}, true},
+		{"amount-lt fails", QueryFilter{HasAmountLT: true, AmountLT: 10}, false},
+		{"description-re match", QueryFilter{DescriptionRe: regexp.MustCompile("(?i)bob")}, true},
+		{"description-re mismatch", QueryFilter{DescriptionRe: regexp.MustCompile("(?i)alice")}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.q.Match(e); got != c.want {
+				t.Fatalf("Match() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+// FuzzQueryFilterMatch asserts Match never panics, regardless of how
+// malformed the date strings or regex-adjacent input are.
+func FuzzQueryFilterMatch(f *testing.F) {
+	f.Add("2025-08-15", "2025-01-01", "2025-12-31", 10.0, "Food", "Coffee")
+	f.Fuzz(func(t *testing.T, date, since, until string, amount float64, category, description string) {
+		q := QueryFilter{Since: since, Until: until, Category: category, HasAmountGT: true, AmountGT: 0}
+		e := Expense{Date: date, Amount: amount, Category: category, Description: description}
+		_ = q.Match(e)
+	})
+}
+
+func TestListWithQueryFlags(t *testing.T) {
+	defer withTempHome(t)()
+
+	if err := cmdAdd([]string{"--description", "Coffee with Bob", "--amount", "5", "--date", "2025-08-10", "--category", "Food"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := cmdAdd([]string{"--description", "Gas station", "--amount", "45", "--date", "2025-08-20", "--category", "Auto"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	out := captureOutput(t, func() {
+		if err := cmdList([]string{"--since", "2025-08-01", "--until", "2025-08-15", "--description-re", "(?i)coffee"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Coffee with Bob") || strings.Contains(out, "Gas station") {
+		t.Fatalf("unexpected filtered list: %s", out)
+	}
+
+	out = captureOutput(t, func() {
+		if err := cmdList([]string{"--amount-gt", "10", "--category-in", "Auto,Food"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Gas station") || strings.Contains(out, "Coffee with Bob") {
+		t.Fatalf("unexpected amount-filtered list: %s", out)
+	}
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fatih/color"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/Khoa-Trinh/expense-tracker-cli/exporters"
+)
+
+// tuiMode tracks which single-line prompt (if any) the TUI is capturing
+// keystrokes into.
+type tuiMode int
+
+const (
+	tuiModeNormal tuiMode = iota
+	tuiModeFilter
+	tuiModeAdd
+	tuiModeEdit
+	tuiModeBudget
+)
+
+// tuiModel is a bubbletea model over the same Store the rest of the CLI
+// reads and writes, so the JSON file on disk stays the single source of
+// truth; every mutation below calls st.save() immediately.
+type tuiModel struct {
+	st       *Store
+	cursor   int
+	month    int
+	year     int
+	filter   string
+	mode     tuiMode
+	input    string
+	editID   int
+	watcher  *fsnotify.Watcher
+	quitting bool
+}
+
+func newTUIModel() (*tuiModel, error) {
+	st, err := loadStore()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	m := &tuiModel{st: st, month: int(now.Month()), year: now.Year()}
+	_ = m.watch() // hot-reload is a nicety; a watcher failure shouldn't block the TUI
+	return m, nil
+}
+
+func (m *tuiModel) watch() error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	path, err := dataPath()
+	if err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Add(filepath.Dir(path)); err != nil {
+		w.Close()
+		return err
+	}
+	m.watcher = w
+	return nil
+}
+
+type fileChangedMsg struct{}
+
+func (m *tuiModel) waitForFileChange() tea.Cmd {
+	if m.watcher == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		select {
+		case _, ok := <-m.watcher.Events:
+			if !ok {
+				return nil
+			}
+			return fileChangedMsg{}
+		case <-m.watcher.Errors:
+			return nil
+		}
+	}
+}
+
+func (m *tuiModel) Init() tea.Cmd { return m.waitForFileChange() }
+
+func (m *tuiModel) visibleItems() []Expense {
+	q := QueryFilter{Month: m.month, Year: m.year}
+	if m.filter != "" {
+		q.DescriptionRe = regexp.MustCompile("(?i)" + regexp.QuoteMeta(m.filter))
+	}
+	return filterExpenses(m.st, q)
+}
+
+func (m *tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case fileChangedMsg:
+		if st, err := loadStore(); err == nil {
+			m.st = st
+		}
+		return m, m.waitForFileChange()
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+func (m *tuiModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.mode != tuiModeNormal {
+		return m.handleInputKey(msg)
+	}
+	switch msg.String() {
+	case "ctrl+c", "q":
+		m.quitting = true
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.visibleItems())-1 {
+			m.cursor++
+		}
+	case "a":
+		m.mode, m.input = tuiModeAdd, ""
+	case "e":
+		items := m.visibleItems()
+		if m.cursor < len(items) {
+			e := items[m.cursor]
+			m.editID = e.ID
+			m.input = fmt.Sprintf("%.2f %s", e.Amount, e.Description)
+			m.mode = tuiModeEdit
+		}
+	case "d":
+		m.deleteSelected()
+	case "/":
+		m.mode, m.input = tuiModeFilter, m.filter
+	case "m":
+		m.month++
+		if m.month > 12 {
+			m.month, m.year = 1, m.year+1
+		}
+		m.cursor = 0
+	case "b":
+		m.mode, m.input = tuiModeBudget, ""
+	case "x":
+		_ = m.exportCSV("tui-export.csv")
+	}
+	return m, nil
+}
+
+func (m *tuiModel) deleteSelected() {
+	items := m.visibleItems()
+	if m.cursor >= len(items) {
+		return
+	}
+	if _, idx := m.st.findByID(items[m.cursor].ID); idx >= 0 {
+		m.st.Expenses = append(m.st.Expenses[:idx], m.st.Expenses[idx+1:]...)
+		_ = m.st.save()
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	}
+}
+
+func (m *tuiModel) handleInputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.mode, m.input = tuiModeNormal, ""
+	case tea.KeyEnter:
+		m.submit()
+	case tea.KeyBackspace:
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+		}
+	default:
+		m.input += msg.String()
+	}
+	return m, nil
+}
+
+// submit applies the current prompt's input and returns to normal mode.
+func (m *tuiModel) submit() {
+	switch m.mode {
+	case tuiModeFilter:
+		m.filter = m.input
+	case tuiModeAdd:
+		if amt, desc, ok := parseAmountDesc(m.input); ok {
+			now := time.Now()
+			e := Expense{
+				ID: m.st.NextID, Date: now.Format(dateLayout), Description: desc,
+				Amount: amt, Category: defaultCat, Account: defaultAccount,
+				CreatedAt: now, UpdatedAt: now,
+			}
+			m.st.Expenses = append(m.st.Expenses, e)
+			m.st.NextID++
+			_ = m.st.save()
+		}
+	case tuiModeEdit:
+		if amt, desc, ok := parseAmountDesc(m.input); ok {
+			if e, _ := m.st.findByID(m.editID); e != nil {
+				e.Amount, e.Description, e.UpdatedAt = amt, desc, time.Now()
+				_ = m.st.save()
+			}
+		}
+	case tuiModeBudget:
+		if v, err := strconv.ParseFloat(strings.TrimSpace(m.input), 64); err == nil {
+			mk := fmt.Sprintf("%04d-%02d", m.year, m.month)
+			m.st.Budgets[mk] = v
+			_ = m.st.save()
+		}
+	}
+	m.mode, m.input = tuiModeNormal, ""
+}
+
+// parseAmountDesc splits "20 Lunch with Bob" into (20, "Lunch with Bob").
+func parseAmountDesc(s string) (float64, string, bool) {
+	parts := strings.SplitN(strings.TrimSpace(s), " ", 2)
+	if len(parts) < 2 {
+		return 0, "", false
+	}
+	amt, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil || amt <= 0 {
+		return 0, "", false
+	}
+	return amt, strings.TrimSpace(parts[1]), true
+}
+
+func (m *tuiModel) exportCSV(path string) error {
+	items := m.visibleItems()
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return exporters.CSVExporter{}.Write(f, toExportRecords(items), buildExportStats(m.st, items))
+}
+
+func (m *tuiModel) View() string {
+	if m.quitting {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s — %04d-%02d\n", appName, m.year, m.month)
+	if m.filter != "" {
+		fmt.Fprintf(&b, "filter: %s\n", m.filter)
+	}
+
+	selected := color.New(color.FgRed)
+	for i, e := range m.visibleItems() {
+		cursor := " "
+		if i == m.cursor {
+			cursor = ">"
+		}
+		line := fmt.Sprintf("%s %-3d %-11s %-32s %-14s $%.2f", cursor, e.ID, e.Date, e.Description, e.Category, e.Amount)
+		if i == m.cursor {
+			line = selected.Sprint(line)
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+
+	switch m.mode {
+	case tuiModeFilter:
+		fmt.Fprintf(&b, "/%s\n", m.input)
+	case tuiModeAdd:
+		fmt.Fprintf(&b, "add (amount description)> %s\n", m.input)
+	case tuiModeEdit:
+		fmt.Fprintf(&b, "edit (amount description)> %s\n", m.input)
+	case tuiModeBudget:
+		fmt.Fprintf(&b, "budget> %s\n", m.input)
+	default:
+		b.WriteString("a add  e edit  d delete  / filter  m month  b budget  x export  q quit\n")
+	}
+	return b.String()
+}
+
+func cmdTUI(args []string) error {
+	m, err := newTUIModel()
+	if err != nil {
+		return err
+	}
+	if m.watcher != nil {
+		defer m.watcher.Close()
+	}
+	_, err = tea.NewProgram(m).Run()
+	return err
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func sendKey(t *testing.T, m *tuiModel, msg tea.KeyMsg) *tuiModel {
+	t.Helper()
+	next, _ := m.Update(msg)
+	updated, ok := next.(*tuiModel)
+	if !ok {
+		t.Fatalf("Update did not return a *tuiModel")
+	}
+	return updated
+}
+
+func sendRunes(t *testing.T, m *tuiModel, s string) *tuiModel {
+	t.Helper()
+	for _, r := range s {
+		m = sendKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestTUIAddKeybindingMutatesStore(t *testing.T) {
+	defer withTempHome(t)()
+
+	m, err := newTUIModel()
+	if err != nil {
+		t.Fatalf("new model: %v", err)
+	}
+	m = sendKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	m = sendRunes(t, m, "20 Lunch")
+	m = sendKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	st, err := loadStore()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(st.Expenses) != 1 || st.Expenses[0].Description != "Lunch" || st.Expenses[0].Amount != 20 {
+		t.Fatalf("expected one $20 Lunch expense, got %+v", st.Expenses)
+	}
+	if m.mode != tuiModeNormal {
+		t.Fatalf("expected mode to reset to normal after submit, got %v", m.mode)
+	}
+}
+
+func TestTUIDeleteKeybindingMutatesStore(t *testing.T) {
+	defer withTempHome(t)()
+	date := time.Now().Format(dateLayout)
+	if err := cmdAdd([]string{"--description", "Coffee", "--amount", "5", "--date", date}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	m, err := newTUIModel()
+	if err != nil {
+		t.Fatalf("new model: %v", err)
+	}
+	m = sendKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+	st, err := loadStore()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(st.Expenses) != 0 {
+		t.Fatalf("expected expense to be deleted, got %+v", st.Expenses)
+	}
+}
+
+func TestTUIFilterKeybinding(t *testing.T) {
+	defer withTempHome(t)()
+	date := time.Now().Format(dateLayout)
+	if err := cmdAdd([]string{"--description", "Coffee", "--amount", "5", "--date", date}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := cmdAdd([]string{"--description", "Gas", "--amount", "40", "--date", date}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	m, err := newTUIModel()
+	if err != nil {
+		t.Fatalf("new model: %v", err)
+	}
+	m = sendKey(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m = sendRunes(t, m, "Cof")
+	m = sendKey(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	items := m.visibleItems()
+	if len(items) != 1 || items[0].Description != "Coffee" {
+		t.Fatalf("expected filter to leave only Coffee, got %+v", items)
+	}
+}